@@ -6,65 +6,70 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"os"
 	"os/exec"
-	"strconv"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
-	"github.com/hashicorp/go-version"
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/hashicorp/terraform-config-inspect/tfconfig"
+	"github.com/nepomuceno/avm-version-check/pkg/logging"
+	"github.com/nepomuceno/avm-version-check/pkg/registry"
+	"github.com/nepomuceno/avm-version-check/pkg/report"
+	"github.com/nepomuceno/avm-version-check/pkg/score"
+	"github.com/nepomuceno/avm-version-check/pkg/vulncheck"
 	"github.com/schollz/progressbar/v3"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
 
-// Providers we want to check against specific minimum versions
-var providersToCheck = map[string]string{
-	"azurerm": "4.0.0",
-	"azapi":   "2.0.0",
+// providersToCheck maps a provider's short name (as it appears in a
+// module's required_providers block) to the Terraform Registry policy used
+// to resolve its compatibility reference version. Overridden wholesale by
+// the "process" command's --providers flag. Every provider a module
+// declares is checked, whether or not it appears here: one with no policy
+// defaults to the hashicorp namespace (see processRecord).
+var providersToCheck = map[string]registry.Policy{
+	"azurerm": {Namespace: "hashicorp", Name: "azurerm"},
+	"azapi":   {Namespace: "Azure", Name: "azapi"},
 }
 
-// CSVRecord represents a row from the input CSV.
-type CSVRecord struct {
-	ProviderNamespace               string
-	ResourceType                    string
-	ModuleDisplayName               string
-	AlternativeNames                string
-	ModuleName                      string
-	ModuleStatus                    string
-	RepoURL                         string
-	PublicRegistryReference         string
-	TelemetryIdPrefix               string
-	PrimaryModuleOwnerGHHandle      string
-	PrimaryModuleOwnerDisplayName   string
-	SecondaryModuleOwnerGHHandle    string
-	SecondaryModuleOwnerDisplayName string
-	ModuleOwnersGHTeam              string
-	ModuleContributorsGHTeam        string
-	Description                     string
-	Comments                        string
-	FirstPublishedIn                string
-}
+// logger is the package-level structured logger configured from the
+// --log-format and --log-level flags in main before any command runs.
+var logger = logrus.New()
+
+// gitAuthToken, when set (via --git-token or $GITHUB_TOKEN), authenticates
+// go-git clones against private mirrors of AVM repos.
+var gitAuthToken string
+
+// gitBinaryPath, when set via --git-binary, clones by shelling out to that
+// git executable instead of using go-git.
+var gitBinaryPath string
+
+// vulnChecker cross-checks discovered provider versions against OSV
+// advisories. It is configured by the "process" command's --vuln-* flags.
+var vulnChecker *vulncheck.Checker
+
+// providerResolver resolves each provider's current Terraform Registry
+// version so compatibility is checked against what's actually published
+// rather than a hardcoded minimum. Configured by "process"'s Action.
+var providerResolver = registry.NewResolver()
+
+// CSVRecord represents a row from the input CSV. The type lives in
+// pkg/report so every output writer can render it without importing main.
+type CSVRecord = report.CSVRecord
 
 // ProviderVersion represents a single provider requirement (like azurerm, ~> 3.0).
-type ProviderVersion struct {
-	ProviderName string `json:"provider_name"`
-	Version      string `json:"version"`
-}
+type ProviderVersion = report.ProviderVersion
 
 // Result holds the analysis result for each module.
-type Result struct {
-	CSVRecord
-	Providers        []ProviderVersion `json:"providers"`
-	Compatibility    map[string]bool   `json:"compatibility"`
-	LastCommitDate   string            `json:"last_commit_date,omitempty"`
-	LastCommitAuthor string            `json:"last_commit_author,omitempty"`
-	Error            string            `json:"error,omitempty"` // Store any processing errors
-}
+type Result = report.Result
 
 // readCSV reads the input CSV file and returns a slice of CSVRecord.
 func readCSV(filename string) ([]CSVRecord, error) {
@@ -134,17 +139,32 @@ func getField(row []string, headerMap map[string]int, field string) string {
 	return ""
 }
 
-// cloneRepo performs a shallow clone of the repository to a temporary directory.
+// cloneRepo performs a shallow clone of the repository to a temporary
+// directory, using go-git unless --git-binary was set to shell out to a
+// git executable instead.
 func cloneRepo(ctx context.Context, repoURL string) (string, error) {
 	tempDir, err := os.MkdirTemp("", "repo-*")
 	if err != nil {
 		return "", err
 	}
-	cmd := exec.CommandContext(ctx, "git", "clone", "--depth", "1", repoURL, tempDir)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
+
+	if gitBinaryPath != "" {
+		cmd := exec.CommandContext(ctx, gitBinaryPath, "clone", "--depth", "1", repoURL, tempDir)
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			os.RemoveAll(tempDir)
+			return "", fmt.Errorf("git clone failed: %v, output: %s", err, string(output))
+		}
+		return tempDir, nil
+	}
+
+	cloneOptions := &git.CloneOptions{URL: repoURL, Depth: 1}
+	if gitAuthToken != "" {
+		cloneOptions.Auth = &githttp.BasicAuth{Username: "avm-version-check", Password: gitAuthToken}
+	}
+	if _, err := git.PlainCloneContext(ctx, tempDir, false, cloneOptions); err != nil {
 		os.RemoveAll(tempDir)
-		return "", fmt.Errorf("git clone failed: %v, output: %s", err, string(output))
+		return "", fmt.Errorf("git clone failed: %v", err)
 	}
 	return tempDir, nil
 }
@@ -167,56 +187,45 @@ func parseTerraformModule(modulePath string) ([]ProviderVersion, error) {
 	return result, nil
 }
 
-// checkVersionConstraints uses go-version to determine if the constraints are satisfied.
-func checkVersionConstraints(currentProviderVersion, constraint string) (bool, error) {
-	ver, err := version.NewVersion(currentProviderVersion)
+// getLastCommitInfo extracts the last commit's committer time and author
+// name from the local repo via go-git, reading HEAD directly instead of
+// shelling out to `git log`.
+func getLastCommitInfo(repoPath string) (string, string, error) {
+	repo, err := git.PlainOpen(repoPath)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse version '%s': %v", currentProviderVersion, err)
+		return "", "", fmt.Errorf("failed to open repo: %v", err)
 	}
-	c, err := version.NewConstraint(constraint)
+	head, err := repo.Head()
 	if err != nil {
-		return false, fmt.Errorf("failed to parse constraint '%s': %v", constraint, err)
+		return "", "", fmt.Errorf("failed to resolve HEAD: %v", err)
 	}
-	return c.Check(ver), nil
-}
-
-// getLastCommitInfo extracts the last commit epoch time and author from the local repo.
-func getLastCommitInfo(ctx context.Context, repoPath string) (string, string, error) {
-	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "log", "-1", "--format=%ct|%an")
-	out, err := cmd.Output()
+	commit, err := repo.CommitObject(head.Hash())
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get last commit: %v", err)
 	}
-	parts := strings.SplitN(string(out), "|", 2)
-	if len(parts) != 2 {
-		return "", "", fmt.Errorf("unexpected format for last commit info")
-	}
-	epochStr := strings.TrimSpace(parts[0])
-	author := strings.TrimSpace(parts[1])
-	epoch, err := strconv.ParseInt(epochStr, 10, 64)
-	if err != nil {
-		return "", "", fmt.Errorf("invalid epoch time '%s': %v", epochStr, err)
-	}
-	t := time.Unix(epoch, 0)
-	return t.Format(time.RFC3339), author, nil
+	return commit.Committer.When.Format(time.RFC3339), commit.Author.Name, nil
 }
 
 // processRecord handles cloning, parsing, and checking for a single CSVRecord.
-func processRecord(ctx context.Context, record CSVRecord, quiet bool) Result {
+func processRecord(ctx context.Context, record CSVRecord) Result {
 	res := Result{CSVRecord: record}
 
 	// Clone the repository with up to 3 retries
 	var repoPath string
 	var err error
 	for attempt := 1; attempt <= 3; attempt++ {
+		start := time.Now()
 		repoPath, err = cloneRepo(ctx, record.RepoURL)
+		duration := time.Since(start)
 		if err == nil {
 			break
 		}
-		// If not quiet, log a warning
-		if !quiet {
-			log.Printf("[WARN] Attempt %d: Failed to clone repo '%s': %v", attempt, record.RepoURL, err)
-		}
+		logger.WithFields(logrus.Fields{
+			"repo_url":    record.RepoURL,
+			"attempt":     attempt,
+			"duration_ms": duration.Milliseconds(),
+			"error":       err,
+		}).Warn("failed to clone repo, retrying")
 		time.Sleep(2 * time.Second)
 	}
 	if err != nil {
@@ -233,25 +242,60 @@ func processRecord(ctx context.Context, record CSVRecord, quiet bool) Result {
 	}
 	res.Providers = providers
 
-	// Check version constraints
-	res.Compatibility = make(map[string]bool)
+	// Check version constraints against every declared provider's resolved
+	// Terraform Registry reference version (a --providers policy's pinned
+	// MinVersion, or else the registry's current latest stable release).
+	// Providers with no explicit policy default to the hashicorp namespace,
+	// which won't resolve for providers published under another namespace
+	// (e.g. Azure/modtm) — a failed lookup is logged and recorded on the
+	// result, but never aborts the run, same as the vulncheck loop below.
+	res.Compatibility = make(map[string]registry.CompatStatus)
 	for _, provider := range providers {
-		if constraint, ok := providersToCheck[provider.ProviderName]; ok {
-			valid, cErr := checkVersionConstraints(constraint, provider.Version)
-			if cErr != nil {
-				res.Error = fmt.Sprintf("failed to check version constraints for provider '%s': %v", provider.ProviderName, cErr)
-				return res
+		policy, ok := providersToCheck[provider.ProviderName]
+		if !ok {
+			policy = registry.Policy{Namespace: "hashicorp", Name: provider.ProviderName}
+		}
+		status, cErr := providerResolver.Evaluate(ctx, policy, provider.Version)
+		if cErr != nil {
+			logger.WithFields(logrus.Fields{
+				"module_name": record.ModuleName,
+				"provider":    provider.ProviderName,
+				"constraint":  provider.Version,
+				"error":       cErr,
+			}).Warn("failed to check version constraints")
+			res.Error = strings.TrimPrefix(fmt.Sprintf("%s | could not check version constraints for provider '%s': %v", res.Error, provider.ProviderName, cErr), " | ")
+			continue
+		}
+		res.Compatibility[provider.ProviderName] = status
+	}
+
+	// Cross-check provider versions against known OSV/GHSA advisories. A
+	// failed lookup is logged and recorded on the result, but never aborts
+	// the run: vulnerability data is best-effort.
+	if vulnChecker != nil {
+		for _, provider := range providers {
+			advisories, vErr := vulnChecker.Query(ctx, provider.ProviderName, provider.Version)
+			if vErr != nil {
+				logger.WithFields(logrus.Fields{
+					"module_name": record.ModuleName,
+					"provider":    provider.ProviderName,
+					"constraint":  provider.Version,
+					"error":       vErr,
+				}).Warn("failed to check provider for known vulnerabilities")
+				res.Error = strings.TrimPrefix(fmt.Sprintf("%s | could not check '%s' for vulnerabilities: %v", res.Error, provider.ProviderName, vErr), " | ")
+				continue
 			}
-			res.Compatibility[provider.ProviderName] = valid
+			res.Vulnerabilities = append(res.Vulnerabilities, advisories...)
 		}
 	}
 
 	// Get last commit info
-	lastDate, author, commitErr := getLastCommitInfo(ctx, repoPath)
+	lastDate, author, commitErr := getLastCommitInfo(repoPath)
 	if commitErr != nil {
-		if !quiet {
-			log.Printf("[WARN] Could not retrieve last commit for '%s': %v", record.RepoURL, commitErr)
-		}
+		logger.WithFields(logrus.Fields{
+			"repo_url": record.RepoURL,
+			"error":    commitErr,
+		}).Warn("could not retrieve last commit info")
 		res.Error = fmt.Sprintf("%s | could not retrieve last commit info: %v", res.Error, commitErr)
 	} else {
 		res.LastCommitDate = lastDate
@@ -261,17 +305,102 @@ func processRecord(ctx context.Context, record CSVRecord, quiet bool) Result {
 	return res
 }
 
-// writeJSON writes the results to an output JSON file (unescaped).
-func writeJSON(filename string, results []Result) error {
-	f, err := os.Create(filename)
-	if err != nil {
-		return err
+// scoreResult clones the module's repo (if reachable) to inspect its
+// governance and directory structure, then computes its health scorecard.
+func scoreResult(ctx context.Context, r Result, weights score.Weights) Result {
+	reachable := !strings.Contains(r.Error, "failed to clone repo")
+
+	var repoPath string
+	if reachable {
+		path, err := cloneRepo(ctx, r.RepoURL)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"repo_url": r.RepoURL,
+				"error":    err,
+			}).Warn("failed to clone repo while scoring")
+			reachable = false
+		} else {
+			repoPath = path
+			defer os.RemoveAll(repoPath)
+		}
 	}
-	defer f.Close()
-	encoder := json.NewEncoder(f)
-	encoder.SetEscapeHTML(false) // keep characters like '>' or '~>' as is
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(results)
+
+	providers := make([]score.ProviderRequirement, 0, len(r.Providers))
+	for _, p := range r.Providers {
+		providers = append(providers, score.ProviderRequirement{Name: p.ProviderName, Constraint: p.Version})
+	}
+
+	var lastCommit time.Time
+	hasLastCommit := false
+	if r.LastCommitDate != "" {
+		if t, err := time.Parse(time.RFC3339, r.LastCommitDate); err == nil {
+			lastCommit = t
+			hasLastCommit = true
+		}
+	}
+
+	scorecard := score.Compute(weights, score.Input{
+		Providers:      providers,
+		ReachableRepo:  reachable,
+		HasLastCommit:  hasLastCommit,
+		LastCommitDate: lastCommit,
+		RepoPath:       repoPath,
+	})
+
+	r.Score = scorecard.Total
+	r.ScoreChecks = scorecard.Checks
+	return r
+}
+
+// parseFormats splits a comma-separated --format value into its individual
+// format names, trimming surrounding whitespace and dropping empty entries
+// so "json, sarif" works the same as "json,sarif".
+func parseFormats(raw string) []string {
+	var formats []string
+	for _, format := range strings.Split(raw, ",") {
+		format = strings.TrimSpace(format)
+		if format == "" {
+			continue
+		}
+		formats = append(formats, format)
+	}
+	return formats
+}
+
+// writeReports renders results in each requested format and writes every
+// one to "<outputBase-without-extension>.<format-extension>", so a single
+// --output value fans out to e.g. output.json and output.sarif.
+func writeReports(results []Result, formats []string, outputBase string) error {
+	trimmed := strings.TrimSuffix(outputBase, filepath.Ext(outputBase))
+	if trimmed == "" {
+		trimmed = outputBase
+	}
+
+	for _, format := range formats {
+		writer, err := report.WriterFor(format)
+		if err != nil {
+			return err
+		}
+		ext, err := report.DefaultExtension(format)
+		if err != nil {
+			return err
+		}
+
+		path := fmt.Sprintf("%s.%s", trimmed, ext)
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create report file '%s': %v", path, err)
+		}
+		if err := writer.Write(f, results); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to write %s report: %v", format, err)
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %s report to '%s'\n", format, path)
+	}
+	return nil
 }
 
 // downloadCSV fetches the CSV file from the given URL and saves it to a local file.
@@ -313,9 +442,22 @@ func downloadCSVIfNeeded(url, filename string, force bool) error {
 	return nil
 }
 
-// summarizeResults returns the counts for unreachable, not-compatible, and dormant repos.
-func summarizeResults(results []Result) (int, int, int) {
-	var unreachableCount, notCompatibleCount, dormantCount int
+// isNotCompatible reports whether any of r's declared providers has a
+// constraint that fails to admit its resolved Terraform Registry reference
+// version.
+func isNotCompatible(r Result) bool {
+	for _, status := range r.Compatibility {
+		if !status.Compatible {
+			return true
+		}
+	}
+	return false
+}
+
+// summarizeResults returns the counts for unreachable, not-compatible, dormant,
+// and vulnerable (pinned to a provider version with a known advisory) repos.
+func summarizeResults(results []Result) (int, int, int, int) {
+	var unreachableCount, notCompatibleCount, dormantCount, vulnerableCount int
 
 	now := time.Now()
 	sixMonthsAgo := now.AddDate(0, -6, 0)
@@ -332,14 +474,14 @@ func summarizeResults(results []Result) (int, int, int) {
 				dormantCount++
 			}
 		}
-		// Not compatible if azurerm or azapi is present in the map but false
-		azurermStatus, hasAzurerm := r.Compatibility["azurerm"]
-		azapiStatus, hasAzapi := r.Compatibility["azapi"]
-		if (hasAzurerm && !azurermStatus) || (hasAzapi && !azapiStatus) {
+		if isNotCompatible(r) {
 			notCompatibleCount++
 		}
+		if len(r.Vulnerabilities) > 0 {
+			vulnerableCount++
+		}
 	}
-	return unreachableCount, notCompatibleCount, dormantCount
+	return unreachableCount, notCompatibleCount, dormantCount, vulnerableCount
 }
 
 func main() {
@@ -347,6 +489,37 @@ func main() {
 		Name:    "avm-version-check",
 		Usage:   "Check Terraform Azure Verified Modules against provider version constraints",
 		Version: "1.0.0",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "log-format",
+				Value: "text",
+				Usage: "Log output format (text|json)",
+			},
+			&cli.StringFlag{
+				Name:  "log-level",
+				Value: "info",
+				Usage: "Log level (debug|info|warn|error)",
+			},
+			&cli.StringFlag{
+				Name:    "git-token",
+				Usage:   "Token used to authenticate git clones (defaults to $GITHUB_TOKEN)",
+				EnvVars: []string{"GITHUB_TOKEN"},
+			},
+			&cli.StringFlag{
+				Name:  "git-binary",
+				Usage: "Path to a git executable to shell out to instead of the in-process go-git client",
+			},
+		},
+		Before: func(c *cli.Context) error {
+			l, err := logging.New(c.String("log-format"), c.String("log-level"))
+			if err != nil {
+				return err
+			}
+			logger = l
+			gitAuthToken = c.String("git-token")
+			gitBinaryPath = c.String("git-binary")
+			return nil
+		},
 		Commands: []*cli.Command{
 			{
 				Name:  "update-source",
@@ -392,7 +565,12 @@ func main() {
 						Name:    "output",
 						Aliases: []string{"o"},
 						Value:   "output.json",
-						Usage:   "Output JSON file for results",
+						Usage:   "Output file for results (extension is replaced per --format)",
+					},
+					&cli.StringFlag{
+						Name:  "format",
+						Value: "json",
+						Usage: "Comma-separated output formats: json,sarif,junit,markdown,csv",
 					},
 					&cli.IntFlag{
 						Name:    "workers",
@@ -418,15 +596,52 @@ func main() {
 						Value:   "https://raw.githubusercontent.com/Azure/Azure-Verified-Modules/refs/heads/main/docs/static/module-indexes/TerraformResourceModules.csv",
 						Usage:   "URL of the CSV file to download if --download is set",
 					},
+					&cli.StringFlag{
+						Name:  "vuln-cache-dir",
+						Value: ".vulncache",
+						Usage: "Directory for caching OSV advisory lookups for 24h (empty disables caching)",
+					},
+					&cli.StringFlag{
+						Name:  "vuln-offline-dir",
+						Usage: "Read OSV advisories from a local mirror directory instead of the network",
+					},
+					&cli.BoolFlag{
+						Name:  "fail-on-vuln",
+						Usage: "Exit with a non-zero status if any module is pinned to a provider version with a known advisory",
+					},
+					&cli.StringFlag{
+						Name:  "providers",
+						Usage: "Comma-separated namespace/name[@min_version] policies, replacing the azurerm/azapi defaults (e.g. hashicorp/azurerm@4.0.0)",
+					},
+					&cli.StringFlag{
+						Name:  "provider-cache-dir",
+						Value: ".providercache",
+						Usage: "Directory for caching Terraform Registry version lookups for 24h (empty disables caching)",
+					},
 				},
 				Action: func(c *cli.Context) error {
 					ctx := context.Background()
 
 					inputCSV := c.String("input")
-					outputJSON := c.String("output")
+					outputPath := c.String("output")
+					formats := parseFormats(c.String("format"))
 					numWorkers := c.Int("workers")
 					quiet := c.Bool("quiet")
 
+					vulnChecker = vulncheck.NewChecker(
+						vulncheck.WithCacheDir(c.String("vuln-cache-dir")),
+						vulncheck.WithOfflineDir(c.String("vuln-offline-dir")),
+					)
+
+					providerResolver = registry.NewResolver(registry.WithCacheDir(c.String("provider-cache-dir")))
+					if spec := c.String("providers"); spec != "" {
+						policies, err := registry.ParsePolicies(spec)
+						if err != nil {
+							return fmt.Errorf("invalid --providers: %v", err)
+						}
+						providersToCheck = policies
+					}
+
 					// If user requested to download the CSV, do it before reading
 					if c.Bool("download") {
 						url := c.String("url")
@@ -436,9 +651,10 @@ func main() {
 						fmt.Println("Downloaded CSV file before processing.")
 					}
 
-					// If "quiet" is true, we set the log output to discard
+					// The --quiet flag maps to the "error" log level, suppressing
+					// clone-retry and git-parsing warnings while still surfacing errors.
 					if quiet {
-						log.SetOutput(io.Discard)
+						logger.SetLevel(logrus.ErrorLevel)
 					}
 
 					records, err := readCSV(inputCSV)
@@ -473,7 +689,7 @@ func main() {
 						go func() {
 							defer wg.Done()
 							for record := range recordChan {
-								r := processRecord(ctx, record, quiet)
+								r := processRecord(ctx, record)
 								resultChan <- r
 
 								// Update progress bar
@@ -499,22 +715,86 @@ func main() {
 						results[idx] = r
 					}
 
-					// Write results to JSON
-					if err := writeJSON(outputJSON, results); err != nil {
-						return fmt.Errorf("error writing output JSON: %v", err)
+					// Write results in every requested format
+					if err := writeReports(results, formats, outputPath); err != nil {
+						return fmt.Errorf("error writing reports: %v", err)
 					}
 
 					// Print a short summary here
-					unreachableCount, notCompatibleCount, dormantCount := summarizeResults(results)
-					fmt.Printf("\nProcessing complete. Results written to '%s'\n", outputJSON)
+					unreachableCount, notCompatibleCount, dormantCount, vulnerableCount := summarizeResults(results)
+					fmt.Println("\nProcessing complete.")
 					fmt.Println("Summary:")
 					fmt.Printf("  - Unreachable repos: %d\n", unreachableCount)
 					fmt.Printf("  - Not-compatible repos: %d\n", notCompatibleCount)
 					fmt.Printf("  - Dormant repos (6+ months): %d\n", dormantCount)
+					fmt.Printf("  - Modules with known provider vulnerabilities: %d\n", vulnerableCount)
+
+					if c.Bool("fail-on-vuln") && vulnerableCount > 0 {
+						return cli.Exit(fmt.Sprintf("%d module(s) are pinned to a provider version with a known advisory", vulnerableCount), 1)
+					}
 
 					return nil
 				},
 			},
+			{
+				Name:  "score",
+				Usage: "Compute a 0-10 health score per module from the JSON output of 'process'",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "input",
+						Aliases: []string{"i"},
+						Value:   "output.json",
+						Usage:   "Input JSON file with processed results",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Value:   "scored.json",
+						Usage:   "Output JSON file with scores added",
+					},
+					&cli.StringFlag{
+						Name:  "weights",
+						Usage: "Path to a YAML file of check-name -> weight overrides",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					ctx := context.Background()
+
+					inputJSON := c.String("input")
+					outputJSON := c.String("output")
+
+					data, err := os.ReadFile(inputJSON)
+					if err != nil {
+						return fmt.Errorf("could not read JSON file '%s': %v", inputJSON, err)
+					}
+					var results []Result
+					if err := json.Unmarshal(data, &results); err != nil {
+						return fmt.Errorf("failed to parse JSON: %v", err)
+					}
+
+					weights := score.DefaultWeights()
+					if weightsPath := c.String("weights"); weightsPath != "" {
+						w, err := score.LoadWeights(weightsPath)
+						if err != nil {
+							return fmt.Errorf("failed to load weights: %v", err)
+						}
+						weights = w
+					}
+
+					fmt.Printf("Scoring %d modules...\n", len(results))
+					for i, r := range results {
+						results[i] = scoreResult(ctx, r, weights)
+					}
+
+					sort.Slice(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+
+					if err := writeReports(results, []string{"json"}, outputJSON); err != nil {
+						return fmt.Errorf("error writing output JSON: %v", err)
+					}
+					fmt.Println("Scoring complete.")
+					return nil
+				},
+			},
 			{
 				Name:  "analysis",
 				Usage: "Perform detailed analysis on the JSON output from 'process'",
@@ -525,6 +805,16 @@ func main() {
 						Value:   "output.json",
 						Usage:   "Input JSON file with processed results",
 					},
+					&cli.StringFlag{
+						Name:  "format",
+						Usage: "Comma-separated report formats to also write out: json,sarif,junit,markdown,csv",
+					},
+					&cli.StringFlag{
+						Name:    "output",
+						Aliases: []string{"o"},
+						Value:   "analysis",
+						Usage:   "Output base name for --format reports (extension is added per format)",
+					},
 				},
 				Action: func(c *cli.Context) error {
 					inputJSON := c.String("input")
@@ -538,12 +828,19 @@ func main() {
 						return fmt.Errorf("failed to parse JSON: %v", err)
 					}
 
+					if formats := parseFormats(c.String("format")); len(formats) > 0 {
+						if err := writeReports(results, formats, c.String("output")); err != nil {
+							return fmt.Errorf("error writing reports: %v", err)
+						}
+					}
+
 					now := time.Now()
 					sixMonthsAgo := now.AddDate(0, -6, 0)
 
 					var unreachable []Result
 					var dormant []Result
 					var notCompatible []Result
+					var vulnerable []Result
 
 					for _, r := range results {
 						if strings.Contains(r.Error, "failed to clone repo") {
@@ -555,11 +852,12 @@ func main() {
 								dormant = append(dormant, r)
 							}
 						}
-						azurermStatus, hasAzurerm := r.Compatibility["azurerm"]
-						azapiStatus, hasAzapi := r.Compatibility["azapi"]
-						if (hasAzurerm && !azurermStatus) || (hasAzapi && !azapiStatus) {
+						if isNotCompatible(r) {
 							notCompatible = append(notCompatible, r)
 						}
+						if len(r.Vulnerabilities) > 0 {
+							vulnerable = append(vulnerable, r)
+						}
 					}
 
 					// Let's colorize the output with ANSI codes and add emojis
@@ -572,8 +870,9 @@ func main() {
 					fmt.Printf("\n%s🔎 Detailed Analysis:%s\n", colorCyan, colorReset)
 					fmt.Printf("  %sRepositories processed:%s %d\n", colorGreen, colorReset, len(results))
 					fmt.Printf("  %sUnreachable repositories:%s %d\n", colorRed, colorReset, len(unreachable))
-					fmt.Printf("  %sNot compatible with azurerm/azapi:%s %d\n", colorRed, colorReset, len(notCompatible))
+					fmt.Printf("  %sNot compatible with a declared provider's registry policy:%s %d\n", colorRed, colorReset, len(notCompatible))
 					fmt.Printf("  %sDormant (6+ months):%s %d\n", colorYellow, colorReset, len(dormant))
+					fmt.Printf("  %sKnown provider vulnerabilities:%s %d\n", colorRed, colorReset, len(vulnerable))
 					fmt.Println()
 
 					if len(notCompatible) > 0 {
@@ -607,6 +906,47 @@ func main() {
 						}
 						fmt.Println()
 					}
+					if len(vulnerable) > 0 {
+						fmt.Printf("%s🛡️  Modules With Known Provider Vulnerabilities:%s\n", colorRed, colorReset)
+						for _, r := range vulnerable {
+							ids := make([]string, len(r.Vulnerabilities))
+							for i, advisory := range r.Vulnerabilities {
+								ids[i] = advisory.ID
+							}
+							fmt.Printf("  - %s [%s]\n", r.RepoURL, strings.Join(ids, ", "))
+						}
+						fmt.Println()
+					}
+
+					// If the JSON came from the `score` subcommand, render a scorecard view.
+					var scored []Result
+					for _, r := range results {
+						if len(r.ScoreChecks) > 0 {
+							scored = append(scored, r)
+						}
+					}
+					if len(scored) > 0 {
+						sort.Slice(scored, func(i, j int) bool { return scored[i].Score > scored[j].Score })
+
+						fmt.Printf("%s📊 Module Health Scores:%s\n", colorCyan, colorReset)
+						for _, r := range scored {
+							fmt.Printf("  - %-50s %4.1f/10\n", r.RepoURL, r.Score)
+						}
+						fmt.Println()
+
+						const lowScoreThreshold = 5.0
+						fmt.Printf("%s⚠️  Low Scorers (below %.1f):%s\n", colorYellow, lowScoreThreshold, colorReset)
+						for _, r := range scored {
+							if r.Score >= lowScoreThreshold {
+								continue
+							}
+							fmt.Printf("  - %s (%.1f/10)\n", r.RepoURL, r.Score)
+							for _, check := range r.ScoreChecks {
+								fmt.Printf("      %-24s %4.1f  %s\n", check.Name, check.Score, check.Reason)
+							}
+						}
+						fmt.Println()
+					}
 
 					fmt.Printf("%s✅ Analysis complete.%s\n", colorGreen, colorReset)
 					return nil
@@ -616,6 +956,6 @@ func main() {
 	}
 
 	if err := app.Run(os.Args); err != nil {
-		log.Fatal(err)
+		logger.Fatal(err)
 	}
 }
@@ -0,0 +1,52 @@
+// Package logging configures the structured logger shared by every
+// avm-version-check command so clone retries, git parsing failures, and
+// general errors can be filtered and aggregated in CI instead of grepped
+// out of free-form printf output.
+package logging
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// New builds a logrus.Logger for the given format ("text" or "json") and
+// level ("debug", "info", "warn", or "error").
+func New(format, level string) (*logrus.Logger, error) {
+	logger := logrus.New()
+
+	switch strings.ToLower(format) {
+	case "", "text":
+		logger.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
+	case "json":
+		logger.SetFormatter(&logrus.JSONFormatter{})
+	default:
+		return nil, fmt.Errorf("unknown log format %q (want \"text\" or \"json\")", format)
+	}
+
+	lvl, err := logrus.ParseLevel(level)
+	if err != nil {
+		return nil, fmt.Errorf("unknown log level %q: %w", level, err)
+	}
+	logger.SetLevel(lvl)
+
+	return logger, nil
+}
+
+// MemoryHook records every entry it fires on so tests can assert on
+// structured fields without parsing rendered log lines.
+type MemoryHook struct {
+	Entries []*logrus.Entry
+}
+
+// Levels reports that the hook wants entries at every level.
+func (h *MemoryHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire appends the entry to the hook's in-memory buffer.
+func (h *MemoryHook) Fire(entry *logrus.Entry) error {
+	h.Entries = append(h.Entries, entry)
+	return nil
+}
@@ -0,0 +1,33 @@
+package logging
+
+import "testing"
+
+func TestNewRejectsUnknownFormat(t *testing.T) {
+	if _, err := New("yaml", "info"); err == nil {
+		t.Fatal("expected an error for an unknown log format")
+	}
+}
+
+func TestNewRejectsUnknownLevel(t *testing.T) {
+	if _, err := New("text", "verbose"); err == nil {
+		t.Fatal("expected an error for an unknown log level")
+	}
+}
+
+func TestMemoryHookCapturesFields(t *testing.T) {
+	logger, err := New("json", "debug")
+	if err != nil {
+		t.Fatalf("New() returned an error: %v", err)
+	}
+
+	hook := &MemoryHook{}
+	logger.AddHook(hook)
+	logger.WithField("repo_url", "https://example.com/repo").Warn("failed to clone repo, retrying")
+
+	if len(hook.Entries) != 1 {
+		t.Fatalf("expected 1 captured entry, got %d", len(hook.Entries))
+	}
+	if got := hook.Entries[0].Data["repo_url"]; got != "https://example.com/repo" {
+		t.Fatalf("expected repo_url field to be captured, got %v", got)
+	}
+}
@@ -0,0 +1,277 @@
+// Package registry resolves a Terraform provider's latest published
+// version from the Terraform Registry, so compatibility can be evaluated
+// against what's actually available today instead of a hardcoded minimum
+// that goes stale every time a provider ships a new major.
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-version"
+)
+
+const defaultBaseURL = "https://registry.terraform.io/v1/providers"
+
+// cacheTTL is how long an on-disk registry lookup is reused before a
+// fresh query is made, to avoid hammering the registry when scanning the
+// full AVM catalog.
+const cacheTTL = 24 * time.Hour
+
+// Policy names a Terraform Registry provider and, optionally, pins the
+// reference version used for compatibility checks instead of "latest".
+type Policy struct {
+	Namespace  string
+	Name       string
+	MinVersion string
+}
+
+// CompatStatus records the outcome of evaluating a module's provider
+// version constraint against a resolved reference version.
+type CompatStatus struct {
+	ReferenceVersion string `json:"reference_version"`
+	Constraint       string `json:"constraint"`
+	Compatible       bool   `json:"compatible"`
+}
+
+// Resolver queries the Terraform Registry for a provider's published
+// versions, caching results in memory for the lifetime of the Resolver and
+// optionally on disk for 24h.
+type Resolver struct {
+	httpClient *http.Client
+	baseURL    string
+	cacheDir   string
+
+	mu       sync.Mutex
+	memCache map[string]string // "namespace/name" -> latest stable version
+}
+
+// Option configures a Resolver.
+type Option func(*Resolver)
+
+// WithHTTPClient overrides the HTTP client used to query the registry.
+func WithHTTPClient(client *http.Client) Option {
+	return func(r *Resolver) { r.httpClient = client }
+}
+
+// WithBaseURL overrides the registry's providers endpoint (primarily for tests).
+func WithBaseURL(url string) Option {
+	return func(r *Resolver) { r.baseURL = url }
+}
+
+// WithCacheDir enables an on-disk cache of resolved latest versions under
+// dir, valid for 24h. An empty dir disables on-disk caching.
+func WithCacheDir(dir string) Option {
+	return func(r *Resolver) { r.cacheDir = dir }
+}
+
+// NewResolver builds a Resolver with the given options.
+func NewResolver(opts ...Option) *Resolver {
+	r := &Resolver{
+		httpClient: http.DefaultClient,
+		baseURL:    defaultBaseURL,
+		memCache:   make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// ParsePolicies parses a comma-separated list of "namespace/name[@min_version]"
+// pairs (as accepted by the --providers flag) into a map keyed by the
+// provider's short name, e.g. "hashicorp/azurerm@4.0.0" -> {"azurerm": {...}}.
+func ParsePolicies(spec string) (map[string]Policy, error) {
+	policies := make(map[string]Policy)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		namespaceAndName, minVersion, _ := strings.Cut(entry, "@")
+		namespace, name, ok := strings.Cut(namespaceAndName, "/")
+		if !ok || namespace == "" || name == "" {
+			return nil, fmt.Errorf("invalid --providers entry %q: expected namespace/name[@min_version]", entry)
+		}
+		policies[name] = Policy{Namespace: namespace, Name: name, MinVersion: minVersion}
+	}
+	return policies, nil
+}
+
+// registryVersionsResponse mirrors the subset of the Terraform Registry
+// provider-versions schema we need.
+type registryVersionsResponse struct {
+	Versions []registryVersion `json:"versions"`
+}
+
+type registryVersion struct {
+	Version string `json:"version"`
+}
+
+// LatestVersion returns the latest stable (non-prerelease) published
+// version for namespace/name, preferring the in-memory per-run cache,
+// then an on-disk 24h cache, then the registry API.
+func (r *Resolver) LatestVersion(ctx context.Context, namespace, name string) (string, error) {
+	key := namespace + "/" + name
+
+	r.mu.Lock()
+	if v, ok := r.memCache[key]; ok {
+		r.mu.Unlock()
+		return v, nil
+	}
+	r.mu.Unlock()
+
+	if v, ok := r.readCache(key); ok {
+		r.rememberInMemory(key, v)
+		return v, nil
+	}
+
+	versions, err := r.fetchVersions(ctx, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	latest, err := latestStable(versions)
+	if err != nil {
+		return "", fmt.Errorf("provider '%s/%s' has no published stable version: %w", namespace, name, err)
+	}
+
+	r.rememberInMemory(key, latest)
+	r.writeCache(key, latest)
+	return latest, nil
+}
+
+func (r *Resolver) rememberInMemory(key, version string) {
+	r.mu.Lock()
+	r.memCache[key] = version
+	r.mu.Unlock()
+}
+
+func (r *Resolver) fetchVersions(ctx context.Context, namespace, name string) ([]string, error) {
+	url := fmt.Sprintf("%s/%s/%s/versions", r.baseURL, namespace, name)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Terraform Registry for '%s/%s': %w", namespace, name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Terraform Registry query for '%s/%s' returned status %d", namespace, name, resp.StatusCode)
+	}
+
+	var out registryVersionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode Terraform Registry response for '%s/%s': %w", namespace, name, err)
+	}
+
+	versions := make([]string, 0, len(out.Versions))
+	for _, v := range out.Versions {
+		versions = append(versions, v.Version)
+	}
+	return versions, nil
+}
+
+// latestStable returns the highest version in versions that isn't a
+// prerelease (e.g. "4.1.0-beta1"), since those shouldn't drive compatibility
+// policy for published modules.
+func latestStable(versions []string) (string, error) {
+	var latest *version.Version
+	for _, raw := range versions {
+		v, err := version.NewVersion(raw)
+		if err != nil || v.Prerelease() != "" {
+			continue
+		}
+		if latest == nil || v.GreaterThan(latest) {
+			latest = v
+		}
+	}
+	if latest == nil {
+		return "", fmt.Errorf("no stable versions found among %d candidates", len(versions))
+	}
+	return latest.Original(), nil
+}
+
+// Evaluate resolves pol's reference version (pol.MinVersion if set,
+// otherwise the registry's latest stable version) and reports whether
+// constraintStr admits it.
+func (r *Resolver) Evaluate(ctx context.Context, pol Policy, constraintStr string) (CompatStatus, error) {
+	reference := pol.MinVersion
+	if reference == "" {
+		latest, err := r.LatestVersion(ctx, pol.Namespace, pol.Name)
+		if err != nil {
+			return CompatStatus{}, err
+		}
+		reference = latest
+	}
+
+	ver, err := version.NewVersion(reference)
+	if err != nil {
+		return CompatStatus{}, fmt.Errorf("failed to parse reference version '%s': %w", reference, err)
+	}
+	constraint, err := version.NewConstraint(constraintStr)
+	if err != nil {
+		return CompatStatus{}, fmt.Errorf("failed to parse constraint '%s': %w", constraintStr, err)
+	}
+
+	return CompatStatus{
+		ReferenceVersion: reference,
+		Constraint:       constraintStr,
+		Compatible:       constraint.Check(ver),
+	}, nil
+}
+
+func (r *Resolver) cachePath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(r.cacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+type cacheEntry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Version   string    `json:"version"`
+}
+
+func (r *Resolver) readCache(key string) (string, bool) {
+	if r.cacheDir == "" {
+		return "", false
+	}
+	data, err := os.ReadFile(r.cachePath(key))
+	if err != nil {
+		return "", false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return "", false
+	}
+	if time.Since(entry.FetchedAt) > cacheTTL {
+		return "", false
+	}
+	return entry.Version, true
+}
+
+func (r *Resolver) writeCache(key, version string) {
+	if r.cacheDir == "" {
+		return
+	}
+	if err := os.MkdirAll(r.cacheDir, 0o755); err != nil {
+		return
+	}
+	data, err := json.Marshal(cacheEntry{FetchedAt: time.Now(), Version: version})
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(r.cachePath(key), data, 0o644)
+}
@@ -0,0 +1,81 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestServer(t *testing.T, versions []string) *httptest.Server {
+	t.Helper()
+	entries := make([]registryVersion, len(versions))
+	for i, v := range versions {
+		entries[i] = registryVersion{Version: v}
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(registryVersionsResponse{Versions: entries})
+	}))
+}
+
+func TestLatestVersionSkipsPrereleases(t *testing.T) {
+	server := newTestServer(t, []string{"3.0.0", "4.1.0-beta1", "4.0.0"})
+	defer server.Close()
+
+	r := NewResolver(WithBaseURL(server.URL))
+	latest, err := r.LatestVersion(context.Background(), "hashicorp", "azurerm")
+	if err != nil {
+		t.Fatalf("LatestVersion returned an error: %v", err)
+	}
+	if latest != "4.0.0" {
+		t.Fatalf("expected latest stable version 4.0.0, got %s", latest)
+	}
+}
+
+func TestEvaluateReportsIncompatibleConstraint(t *testing.T) {
+	server := newTestServer(t, []string{"4.0.0"})
+	defer server.Close()
+
+	r := NewResolver(WithBaseURL(server.URL))
+	status, err := r.Evaluate(context.Background(), Policy{Namespace: "hashicorp", Name: "azurerm"}, "~> 3.0")
+	if err != nil {
+		t.Fatalf("Evaluate returned an error: %v", err)
+	}
+	if status.Compatible {
+		t.Fatalf("expected ~> 3.0 to be incompatible with reference 4.0.0, got %+v", status)
+	}
+	if status.ReferenceVersion != "4.0.0" {
+		t.Fatalf("expected reference version 4.0.0, got %s", status.ReferenceVersion)
+	}
+}
+
+func TestEvaluateHonorsMinVersionOverride(t *testing.T) {
+	r := NewResolver(WithBaseURL("http://unused.invalid"))
+	status, err := r.Evaluate(context.Background(), Policy{Namespace: "hashicorp", Name: "azurerm", MinVersion: "4.0.0"}, "~> 4.0")
+	if err != nil {
+		t.Fatalf("Evaluate returned an error: %v", err)
+	}
+	if !status.Compatible {
+		t.Fatalf("expected ~> 4.0 to admit pinned reference 4.0.0, got %+v", status)
+	}
+}
+
+func TestParsePoliciesParsesNamespaceNameAndMinVersion(t *testing.T) {
+	policies, err := ParsePolicies("hashicorp/azurerm@4.0.0,Azure/azapi")
+	if err != nil {
+		t.Fatalf("ParsePolicies returned an error: %v", err)
+	}
+	if policies["azurerm"] != (Policy{Namespace: "hashicorp", Name: "azurerm", MinVersion: "4.0.0"}) {
+		t.Fatalf("unexpected azurerm policy: %+v", policies["azurerm"])
+	}
+	if policies["azapi"] != (Policy{Namespace: "Azure", Name: "azapi"}) {
+		t.Fatalf("unexpected azapi policy: %+v", policies["azapi"])
+	}
+}
+
+func TestParsePoliciesRejectsMissingName(t *testing.T) {
+	if _, err := ParsePolicies("hashicorp"); err == nil {
+		t.Fatal("expected an error for an entry missing a provider name")
+	}
+}
@@ -0,0 +1,67 @@
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/nepomuceno/avm-version-check/pkg/registry"
+)
+
+// CSVWriter renders results as a flat, round-trip friendly CSV: one row
+// per module, with providers and compatibility flattened into
+// "name=value" pairs so the file can be parsed back without a schema.
+type CSVWriter struct{}
+
+var csvHeader = []string{
+	"repo_url", "module_name", "owner", "providers", "compatibility",
+	"last_commit_date", "last_commit_author", "score", "error",
+}
+
+func (CSVWriter) Write(w io.Writer, results []Result) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if err := cw.Write([]string{
+			r.RepoURL,
+			r.ModuleName,
+			r.PrimaryModuleOwnerGHHandle,
+			encodeProviders(r.Providers),
+			encodeCompatibility(r.Compatibility),
+			r.LastCommitDate,
+			r.LastCommitAuthor,
+			fmt.Sprintf("%.2f", r.Score),
+			r.Error,
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func encodeProviders(providers []ProviderVersion) string {
+	parts := make([]string, 0, len(providers))
+	for _, p := range providers {
+		parts = append(parts, fmt.Sprintf("%s@%s", p.ProviderName, p.Version))
+	}
+	return strings.Join(parts, ";")
+}
+
+func encodeCompatibility(compat map[string]registry.CompatStatus) string {
+	names := make([]string, 0, len(compat))
+	for name := range compat {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		status := compat[name]
+		parts = append(parts, fmt.Sprintf("%s=%t(%s vs %s)", name, status.Compatible, status.Constraint, status.ReferenceVersion))
+	}
+	return strings.Join(parts, ";")
+}
@@ -0,0 +1,17 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// JSONWriter renders results as indented JSON, matching the shape
+// avm-version-check has always written to output.json.
+type JSONWriter struct{}
+
+func (JSONWriter) Write(w io.Writer, results []Result) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false) // keep characters like '>' or '~>' as is
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
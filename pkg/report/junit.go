@@ -0,0 +1,60 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// JUnitWriter renders results as a JUnit XML test suite, one testcase per
+// module, so CI systems can fail a build when any non-compatible module
+// appears.
+type JUnitWriter struct{}
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func (JUnitWriter) Write(w io.Writer, results []Result) error {
+	suite := junitTestSuite{
+		Name:  "avm-version-check",
+		Tests: len(results),
+	}
+	for _, r := range results {
+		testCase := junitTestCase{
+			Name:      r.ModuleName,
+			ClassName: r.RepoURL,
+		}
+		if problems := incompatibleProviders(r); len(problems) > 0 {
+			suite.Failures++
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("incompatible providers: %s", strings.Join(problems, ", ")),
+				Text:    fmt.Sprintf("%s does not satisfy the required minimum version for: %s", r.ModuleName, strings.Join(problems, ", ")),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	return encoder.Encode(suite)
+}
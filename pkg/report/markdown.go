@@ -0,0 +1,58 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// MarkdownWriter renders results as a table grouped by owner, suitable
+// for pasting into a PR or issue.
+type MarkdownWriter struct{}
+
+func (MarkdownWriter) Write(w io.Writer, results []Result) error {
+	byOwner := make(map[string][]Result)
+	for _, r := range results {
+		owner := r.PrimaryModuleOwnerGHHandle
+		if owner == "" {
+			owner = "(unassigned)"
+		}
+		byOwner[owner] = append(byOwner[owner], r)
+	}
+
+	owners := make([]string, 0, len(byOwner))
+	for owner := range byOwner {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+
+	for _, owner := range owners {
+		fmt.Fprintf(w, "## %s\n\n", owner)
+		fmt.Fprintf(w, "| Module | Repo | Compatibility | Score | Last Commit |\n")
+		fmt.Fprintf(w, "| --- | --- | --- | --- | --- |\n")
+		for _, r := range byOwner[owner] {
+			fmt.Fprintf(w, "| %s | %s | %s | %s | %s |\n",
+				r.ModuleName, r.RepoURL, compatibilitySummary(r), scoreSummary(r), r.LastCommitDate)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+func compatibilitySummary(r Result) string {
+	if len(r.Compatibility) == 0 {
+		return "n/a"
+	}
+	if problems := incompatibleProviders(r); len(problems) > 0 {
+		return "❌ " + strings.Join(problems, ", ")
+	}
+	return "✅"
+}
+
+func scoreSummary(r Result) string {
+	if len(r.ScoreChecks) == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%.1f/10", r.Score)
+}
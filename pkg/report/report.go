@@ -0,0 +1,51 @@
+// Package report renders processed module results into the output
+// formats CI systems expect, instead of forcing every consumer to parse
+// avm-version-check's own JSON shape.
+package report
+
+import (
+	"fmt"
+	"io"
+)
+
+// Writer renders a set of results to w in a specific output format.
+type Writer interface {
+	Write(w io.Writer, results []Result) error
+}
+
+// WriterFor returns the Writer for the given format name.
+func WriterFor(format string) (Writer, error) {
+	switch format {
+	case "json":
+		return JSONWriter{}, nil
+	case "sarif":
+		return SARIFWriter{}, nil
+	case "junit":
+		return JUnitWriter{}, nil
+	case "markdown":
+		return MarkdownWriter{}, nil
+	case "csv":
+		return CSVWriter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown report format %q", format)
+	}
+}
+
+// DefaultExtension returns the file extension conventionally used for a
+// format, so callers can derive per-format output paths from one base name.
+func DefaultExtension(format string) (string, error) {
+	switch format {
+	case "json":
+		return "json", nil
+	case "sarif":
+		return "sarif", nil
+	case "junit":
+		return "xml", nil
+	case "markdown":
+		return "md", nil
+	case "csv":
+		return "csv", nil
+	default:
+		return "", fmt.Errorf("unknown report format %q", format)
+	}
+}
@@ -0,0 +1,71 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/nepomuceno/avm-version-check/pkg/registry"
+)
+
+func sampleResults() []Result {
+	return []Result{
+		{
+			CSVRecord: CSVRecord{
+				ModuleName:                 "terraform-azurerm-avm-res-storage",
+				RepoURL:                    "https://github.com/Azure/terraform-azurerm-avm-res-storage",
+				PrimaryModuleOwnerGHHandle: "alice",
+			},
+			Providers: []ProviderVersion{{ProviderName: "azurerm", Version: "~> 3.0"}},
+			Compatibility: map[string]registry.CompatStatus{
+				"azurerm": {ReferenceVersion: "4.0.0", Constraint: "~> 3.0", Compatible: false},
+			},
+			Score: 4.5,
+		},
+	}
+}
+
+func TestWriterForUnknownFormat(t *testing.T) {
+	if _, err := WriterFor("yaml"); err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+}
+
+func TestSARIFWriterEmitsResultPerIncompatibleProvider(t *testing.T) {
+	w, err := WriterFor("sarif")
+	if err != nil {
+		t.Fatalf("WriterFor returned an error: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := w.Write(&buf, sampleResults()); err != nil {
+		t.Fatalf("Write returned an error: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"ruleId": "azurerm"`) {
+		t.Errorf("expected a SARIF result with ruleId azurerm, got:\n%s", buf.String())
+	}
+	if !strings.Contains(buf.String(), `"name": "avm-version-check"`) {
+		t.Errorf("expected the tool driver name to be set, got:\n%s", buf.String())
+	}
+}
+
+func TestJUnitWriterFailsIncompatibleModules(t *testing.T) {
+	w, _ := WriterFor("junit")
+	var buf bytes.Buffer
+	if err := w.Write(&buf, sampleResults()); err != nil {
+		t.Fatalf("Write returned an error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "<failure") {
+		t.Errorf("expected a <failure> element for the incompatible module, got:\n%s", buf.String())
+	}
+}
+
+func TestCSVWriterRoundTripsProviders(t *testing.T) {
+	w, _ := WriterFor("csv")
+	var buf bytes.Buffer
+	if err := w.Write(&buf, sampleResults()); err != nil {
+		t.Fatalf("Write returned an error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "azurerm@~> 3.0") {
+		t.Errorf("expected providers to be encoded in the CSV output, got:\n%s", buf.String())
+	}
+}
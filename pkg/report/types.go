@@ -0,0 +1,65 @@
+package report
+
+import (
+	"sort"
+
+	"github.com/nepomuceno/avm-version-check/pkg/registry"
+	"github.com/nepomuceno/avm-version-check/pkg/score"
+	"github.com/nepomuceno/avm-version-check/pkg/vulncheck"
+)
+
+// CSVRecord represents a row from the AVM module index CSV.
+type CSVRecord struct {
+	ProviderNamespace               string
+	ResourceType                    string
+	ModuleDisplayName               string
+	AlternativeNames                string
+	ModuleName                      string
+	ModuleStatus                    string
+	RepoURL                         string
+	PublicRegistryReference         string
+	TelemetryIdPrefix               string
+	PrimaryModuleOwnerGHHandle      string
+	PrimaryModuleOwnerDisplayName   string
+	SecondaryModuleOwnerGHHandle    string
+	SecondaryModuleOwnerDisplayName string
+	ModuleOwnersGHTeam              string
+	ModuleContributorsGHTeam        string
+	Description                     string
+	Comments                        string
+	FirstPublishedIn                string
+}
+
+// ProviderVersion represents a single provider requirement (like azurerm, ~> 3.0).
+type ProviderVersion struct {
+	ProviderName string `json:"provider_name"`
+	Version      string `json:"version"`
+}
+
+// Result holds the analysis result for each module. It is the single
+// model every output writer (json, sarif, junit, markdown, csv) renders.
+type Result struct {
+	CSVRecord
+	Providers        []ProviderVersion                `json:"providers"`
+	Compatibility    map[string]registry.CompatStatus `json:"compatibility"`
+	LastCommitDate   string                           `json:"last_commit_date,omitempty"`
+	LastCommitAuthor string                           `json:"last_commit_author,omitempty"`
+	Score            float64                          `json:"score,omitempty"`
+	ScoreChecks      []score.Check                    `json:"score_checks,omitempty"`
+	Vulnerabilities  []vulncheck.Advisory             `json:"vulnerabilities,omitempty"`
+	Error            string                           `json:"error,omitempty"` // Store any processing errors
+}
+
+// incompatibleProviders returns the providers in r.Compatibility whose
+// declared constraint does not admit the resolved reference version,
+// sorted for deterministic output.
+func incompatibleProviders(r Result) []string {
+	var names []string
+	for name, status := range r.Compatibility {
+		if !status.Compatible {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,295 @@
+// Package score computes a scorecard-style 0-10 health score for an Azure
+// Verified Module from a handful of weighted signals: provider
+// compatibility, commit dormancy, repository governance, directory
+// structure, provider version pinning, and repo reachability.
+package score
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+	"gopkg.in/yaml.v3"
+)
+
+// Check names double as the keys used to look up weights, so a weights
+// config file and a Check.Name always refer to the same signal.
+const (
+	CheckProviderCompatibility = "provider_compatibility"
+	CheckDormancy              = "dormancy"
+	CheckGovernance            = "governance"
+	CheckStructure             = "structure"
+	CheckVersionPinning        = "version_pinning"
+	CheckReachability          = "reachability"
+)
+
+// Weights maps a check name to the weight it contributes to the weighted
+// mean. Checks with no entry default to a weight of 1.
+type Weights map[string]float64
+
+// DefaultWeights returns the weights used when no config file is given.
+func DefaultWeights() Weights {
+	return Weights{
+		CheckProviderCompatibility: 3,
+		CheckDormancy:              2,
+		CheckGovernance:            2,
+		CheckStructure:             1,
+		CheckVersionPinning:        1,
+		CheckReachability:          1,
+	}
+}
+
+// LoadWeights reads a YAML file of check-name -> weight overrides and
+// merges them over DefaultWeights.
+func LoadWeights(path string) (Weights, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read weights file '%s': %w", path, err)
+	}
+	overrides := make(map[string]float64)
+	if err := yaml.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse weights file '%s': %w", path, err)
+	}
+	weights := DefaultWeights()
+	for name, w := range overrides {
+		weights[name] = w
+	}
+	return weights, nil
+}
+
+// ProviderRequirement is a provider name paired with the version
+// constraint a module declares for it.
+type ProviderRequirement struct {
+	Name       string
+	Constraint string
+}
+
+// Check is a single scored signal: a normalized 0-10 score plus the
+// human-readable reason behind it.
+type Check struct {
+	Name   string  `json:"name"`
+	Score  float64 `json:"score"`
+	Reason string  `json:"reason"`
+}
+
+// Report is the full scorecard for one module.
+type Report struct {
+	Total  float64 `json:"total"`
+	Checks []Check `json:"checks"`
+}
+
+// Input bundles everything Compute needs to score a single module,
+// independent of the caller's CSV/result types.
+type Input struct {
+	Providers      []ProviderRequirement
+	ReachableRepo  bool
+	HasLastCommit  bool
+	LastCommitDate time.Time
+	// RepoPath is the local clone used to look for CODEOWNERS, SECURITY.md,
+	// CI workflows, examples/, and tests/. Left empty, the governance and
+	// structure checks score as unknown rather than failing.
+	RepoPath string
+}
+
+// minimumProviderVersions mirrors the compatibility policy in main:
+// azurerm >= 4.0.0, azapi >= 2.0.0.
+var minimumProviderVersions = map[string]string{
+	"azurerm": "4.0.0",
+	"azapi":   "2.0.0",
+}
+
+// Compute runs every check against in and returns the scorecard.
+func Compute(weights Weights, in Input) Report {
+	checks := []Check{
+		providerCompatibilityCheck(in.Providers),
+		dormancyCheck(in.HasLastCommit, in.LastCommitDate),
+		governanceCheck(in.RepoPath),
+		structureCheck(in.RepoPath),
+		versionPinningCheck(in.Providers),
+		reachabilityCheck(in.ReachableRepo),
+	}
+	return Report{
+		Total:  weightedMean(weights, checks),
+		Checks: checks,
+	}
+}
+
+func weightedMean(weights Weights, checks []Check) float64 {
+	var sumScore, sumWeight float64
+	for _, c := range checks {
+		w, ok := weights[c.Name]
+		if !ok {
+			w = 1
+		}
+		sumScore += c.Score * w
+		sumWeight += w
+	}
+	if sumWeight == 0 {
+		return 0
+	}
+	return sumScore / sumWeight
+}
+
+func findConstraint(providers []ProviderRequirement, name string) (string, bool) {
+	for _, p := range providers {
+		if p.Name == name {
+			return p.Constraint, true
+		}
+	}
+	return "", false
+}
+
+func providerCompatibilityCheck(providers []ProviderRequirement) Check {
+	var found, compatible int
+	var problems []string
+	for name, minimum := range minimumProviderVersions {
+		constraint, ok := findConstraint(providers, name)
+		if !ok {
+			continue
+		}
+		found++
+		ok, err := constraintAdmits(constraint, minimum)
+		if err == nil && ok {
+			compatible++
+			continue
+		}
+		problems = append(problems, fmt.Sprintf("%s %s does not admit %s", name, constraint, minimum))
+	}
+	if found == 0 {
+		return Check{Name: CheckProviderCompatibility, Score: 5, Reason: "no azurerm/azapi requirement declared"}
+	}
+	reason := "declared constraints admit the required minimum versions"
+	if len(problems) > 0 {
+		reason = strings.Join(problems, "; ")
+	}
+	return Check{
+		Name:   CheckProviderCompatibility,
+		Score:  10 * float64(compatible) / float64(found),
+		Reason: reason,
+	}
+}
+
+// constraintAdmits reports whether minimum satisfies constraint.
+func constraintAdmits(constraint, minimum string) (bool, error) {
+	ver, err := version.NewVersion(minimum)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse version '%s': %w", minimum, err)
+	}
+	c, err := version.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse constraint '%s': %w", constraint, err)
+	}
+	return c.Check(ver), nil
+}
+
+func dormancyCheck(hasLastCommit bool, lastCommit time.Time) Check {
+	if !hasLastCommit {
+		return Check{Name: CheckDormancy, Score: 5, Reason: "last commit date unknown"}
+	}
+	age := time.Since(lastCommit)
+	switch {
+	case age < 30*24*time.Hour:
+		return Check{Name: CheckDormancy, Score: 10, Reason: "last commit under 30 days ago"}
+	case age < 90*24*time.Hour:
+		return Check{Name: CheckDormancy, Score: 8, Reason: "last commit under 90 days ago"}
+	case age < 180*24*time.Hour:
+		return Check{Name: CheckDormancy, Score: 6, Reason: "last commit under 180 days ago"}
+	case age < 365*24*time.Hour:
+		return Check{Name: CheckDormancy, Score: 3, Reason: "last commit under 365 days ago"}
+	default:
+		return Check{Name: CheckDormancy, Score: 0, Reason: "last commit over a year ago"}
+	}
+}
+
+func governanceCheck(repoPath string) Check {
+	if repoPath == "" {
+		return Check{Name: CheckGovernance, Score: 5, Reason: "repository not available locally to inspect"}
+	}
+	signals := map[string]bool{
+		"CODEOWNERS":         pathExists(repoPath, "CODEOWNERS") || pathExists(repoPath, ".github/CODEOWNERS"),
+		"SECURITY.md":        pathExists(repoPath, "SECURITY.md"),
+		".github/workflows/": dirHasEntries(repoPath, ".github/workflows"),
+	}
+	var present, missing []string
+	for name, ok := range signals {
+		if ok {
+			present = append(present, name)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	reason := "has CODEOWNERS, SECURITY.md, and a CI workflow"
+	if len(missing) > 0 {
+		reason = "missing " + strings.Join(missing, ", ")
+	}
+	return Check{
+		Name:   CheckGovernance,
+		Score:  10 * float64(len(present)) / float64(len(signals)),
+		Reason: reason,
+	}
+}
+
+func structureCheck(repoPath string) Check {
+	if repoPath == "" {
+		return Check{Name: CheckStructure, Score: 5, Reason: "repository not available locally to inspect"}
+	}
+	hasExamples := dirHasEntries(repoPath, "examples")
+	hasTests := dirHasEntries(repoPath, "tests")
+	present := 0
+	var missing []string
+	if hasExamples {
+		present++
+	} else {
+		missing = append(missing, "examples/")
+	}
+	if hasTests {
+		present++
+	} else {
+		missing = append(missing, "tests/")
+	}
+	reason := "has examples/ and tests/ directories"
+	if len(missing) > 0 {
+		reason = "missing " + strings.Join(missing, ", ")
+	}
+	return Check{Name: CheckStructure, Score: 10 * float64(present) / 2, Reason: reason}
+}
+
+func versionPinningCheck(providers []ProviderRequirement) Check {
+	if len(providers) == 0 {
+		return Check{Name: CheckVersionPinning, Score: 5, Reason: "no provider requirements declared"}
+	}
+	var pinned int
+	for _, p := range providers {
+		if strings.Contains(p.Constraint, "~>") {
+			pinned++
+		}
+	}
+	reason := "all provider constraints are pinned with ~>"
+	if pinned < len(providers) {
+		reason = fmt.Sprintf("%d/%d provider constraints are unbounded (no ~>)", len(providers)-pinned, len(providers))
+	}
+	return Check{
+		Name:   CheckVersionPinning,
+		Score:  10 * float64(pinned) / float64(len(providers)),
+		Reason: reason,
+	}
+}
+
+func reachabilityCheck(reachable bool) Check {
+	if reachable {
+		return Check{Name: CheckReachability, Score: 10, Reason: "repository cloned successfully"}
+	}
+	return Check{Name: CheckReachability, Score: 0, Reason: "repository could not be cloned"}
+}
+
+func pathExists(repoPath, relative string) bool {
+	_, err := os.Stat(repoPath + "/" + relative)
+	return err == nil
+}
+
+func dirHasEntries(repoPath, relative string) bool {
+	entries, err := os.ReadDir(repoPath + "/" + relative)
+	return err == nil && len(entries) > 0
+}
@@ -0,0 +1,66 @@
+package score
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestDormancyCheckBuckets(t *testing.T) {
+	cases := []struct {
+		age  time.Duration
+		want float64
+	}{
+		{age: 10 * 24 * time.Hour, want: 10},
+		{age: 60 * 24 * time.Hour, want: 8},
+		{age: 120 * 24 * time.Hour, want: 6},
+		{age: 300 * 24 * time.Hour, want: 3},
+		{age: 400 * 24 * time.Hour, want: 0},
+	}
+	for _, c := range cases {
+		got := dormancyCheck(true, time.Now().Add(-c.age))
+		if got.Score != c.want {
+			t.Errorf("age %v: got score %v, want %v", c.age, got.Score, c.want)
+		}
+	}
+}
+
+func TestProviderCompatibilityCheck(t *testing.T) {
+	compatible := []ProviderRequirement{{Name: "azurerm", Constraint: ">= 4.0.0"}, {Name: "azapi", Constraint: ">= 2.0.0"}}
+	if got := providerCompatibilityCheck(compatible); got.Score != 10 {
+		t.Errorf("expected full score for compatible constraints, got %v (%s)", got.Score, got.Reason)
+	}
+
+	incompatible := []ProviderRequirement{{Name: "azurerm", Constraint: "~> 3.0"}}
+	if got := providerCompatibilityCheck(incompatible); got.Score != 0 {
+		t.Errorf("expected zero score for incompatible constraint, got %v (%s)", got.Score, got.Reason)
+	}
+}
+
+func TestWeightedMeanUsesDefaultWeightForUnknownCheck(t *testing.T) {
+	weights := Weights{"known": 3}
+	checks := []Check{{Name: "known", Score: 10}, {Name: "unknown", Score: 0}}
+	got := weightedMean(weights, checks)
+	want := (10*3 + 0*1) / 4.0
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestLoadWeightsMergesOverDefaults(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/weights.yaml"
+	if err := os.WriteFile(path, []byte("dormancy: 9\n"), 0o644); err != nil {
+		t.Fatalf("failed to write weights file: %v", err)
+	}
+	weights, err := LoadWeights(path)
+	if err != nil {
+		t.Fatalf("LoadWeights returned an error: %v", err)
+	}
+	if weights[CheckDormancy] != 9 {
+		t.Errorf("expected dormancy weight override to apply, got %v", weights[CheckDormancy])
+	}
+	if weights[CheckProviderCompatibility] != DefaultWeights()[CheckProviderCompatibility] {
+		t.Errorf("expected non-overridden weights to keep their default")
+	}
+}
@@ -0,0 +1,484 @@
+// Package vulncheck cross-checks Terraform provider version constraints
+// against known OSV/GHSA advisories, so a module pinned to a provider
+// version with a published CVE can be flagged without a human having to
+// cross-reference the advisory database by hand.
+package vulncheck
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+)
+
+const defaultBaseURL = "https://api.osv.dev/v1/query"
+
+// cacheTTL is how long a cached (provider, constraint) lookup is reused
+// before a fresh query is made, to avoid rate-limiting when scanning the
+// full AVM catalog.
+const cacheTTL = 24 * time.Hour
+
+// Advisory is a known vulnerability applicable to a provider's declared
+// version constraint.
+type Advisory struct {
+	ID      string   `json:"id"`
+	Summary string   `json:"summary,omitempty"`
+	Aliases []string `json:"aliases,omitempty"`
+	URL     string   `json:"url,omitempty"`
+}
+
+// Checker queries (or mirrors) OSV advisories for Terraform providers.
+type Checker struct {
+	httpClient *http.Client
+	baseURL    string
+	cacheDir   string
+	offlineDir string
+}
+
+// Option configures a Checker.
+type Option func(*Checker)
+
+// WithHTTPClient overrides the HTTP client used to query OSV.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Checker) { c.httpClient = client }
+}
+
+// WithBaseURL overrides the OSV query endpoint (primarily for tests).
+func WithBaseURL(url string) Option {
+	return func(c *Checker) { c.baseURL = url }
+}
+
+// WithCacheDir enables an on-disk cache of (provider, constraint) lookups
+// under dir, valid for 24h. An empty dir disables caching.
+func WithCacheDir(dir string) Option {
+	return func(c *Checker) { c.cacheDir = dir }
+}
+
+// WithOfflineDir switches the Checker to read advisories from a local OSV
+// mirror directory instead of the network. Each candidate package is read
+// from "<dir>/<sanitized-package-name>.json" in the OSV query-response
+// shape; a missing file means "no known advisories".
+func WithOfflineDir(dir string) Option {
+	return func(c *Checker) { c.offlineDir = dir }
+}
+
+// NewChecker builds a Checker with the given options.
+func NewChecker(opts ...Option) *Checker {
+	c := &Checker{
+		httpClient: http.DefaultClient,
+		baseURL:    defaultBaseURL,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// osvPackage identifies a package in OSV's ecosystem taxonomy.
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+// osvVuln mirrors the subset of the OSV vulnerability schema we need.
+type osvVuln struct {
+	ID       string        `json:"id"`
+	Summary  string        `json:"summary"`
+	Aliases  []string      `json:"aliases"`
+	Affected []osvAffected `json:"affected"`
+}
+
+type osvAffected struct {
+	Ranges []osvRange `json:"ranges"`
+}
+
+type osvRange struct {
+	Events []osvEvent `json:"events"`
+}
+
+type osvEvent struct {
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+}
+
+type osvQueryResponse struct {
+	Vulns []osvVuln `json:"vulns"`
+}
+
+// candidatePackages returns the OSV package identities that might carry
+// advisories for a Terraform provider: its GitHub-hosted Go module path.
+func candidatePackages(providerName string) []osvPackage {
+	return []osvPackage{
+		{Name: fmt.Sprintf("github.com/hashicorp/terraform-provider-%s", providerName), Ecosystem: "Go"},
+	}
+}
+
+// Query returns the advisories applicable to a provider's declared version
+// constraint: advisories where some version satisfying constraintStr also
+// falls inside the advisory's affected range.
+func (c *Checker) Query(ctx context.Context, providerName, constraintStr string) ([]Advisory, error) {
+	if cached, ok := c.readCache(providerName, constraintStr); ok {
+		return cached, nil
+	}
+
+	lower, upper, err := parseConstraintBounds(constraintStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse constraint '%s': %w", constraintStr, err)
+	}
+
+	vulns, err := c.fetchVulns(ctx, providerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query OSV for provider '%s': %w", providerName, err)
+	}
+
+	var advisories []Advisory
+	for _, v := range vulns {
+		if vulnApplies(v, lower, upper) {
+			advisories = append(advisories, Advisory{
+				ID:      v.ID,
+				Summary: v.Summary,
+				Aliases: v.Aliases,
+				URL:     fmt.Sprintf("https://osv.dev/vulnerability/%s", v.ID),
+			})
+		}
+	}
+
+	c.writeCache(providerName, constraintStr, advisories)
+	return advisories, nil
+}
+
+func (c *Checker) fetchVulns(ctx context.Context, providerName string) ([]osvVuln, error) {
+	if c.offlineDir != "" {
+		return c.fetchVulnsOffline(providerName)
+	}
+
+	var all []osvVuln
+	seen := make(map[string]bool)
+	for _, pkg := range candidatePackages(providerName) {
+		vulns, err := c.queryOSV(ctx, pkg)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vulns {
+			if !seen[v.ID] {
+				seen[v.ID] = true
+				all = append(all, v)
+			}
+		}
+	}
+	return all, nil
+}
+
+func (c *Checker) queryOSV(ctx context.Context, pkg osvPackage) ([]osvVuln, error) {
+	body, err := json.Marshal(map[string]osvPackage{"package": pkg})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OSV query returned status %d", resp.StatusCode)
+	}
+
+	var out osvQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("failed to decode OSV response: %w", err)
+	}
+	return out.Vulns, nil
+}
+
+func (c *Checker) fetchVulnsOffline(providerName string) ([]osvVuln, error) {
+	var all []osvVuln
+	for _, pkg := range candidatePackages(providerName) {
+		path := filepath.Join(c.offlineDir, sanitizeFilename(pkg.Name)+".json")
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read offline OSV mirror file '%s': %w", path, err)
+		}
+		var out osvQueryResponse
+		if err := json.Unmarshal(data, &out); err != nil {
+			return nil, fmt.Errorf("failed to parse offline OSV mirror file '%s': %w", path, err)
+		}
+		all = append(all, out.Vulns...)
+	}
+	return all, nil
+}
+
+func sanitizeFilename(name string) string {
+	return strings.NewReplacer("/", "_", ":", "_").Replace(name)
+}
+
+// vulnApplies reports whether any version admitted by the constraint
+// interval [lower, upper) also falls within one of v's affected ranges.
+func vulnApplies(v osvVuln, lower, upper *bound) bool {
+	for _, affected := range v.Affected {
+		for _, r := range affected.Ranges {
+			if rangeIntersectsConstraint(r, lower, upper) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rangeIntersectsConstraint reports whether an OSV introduced/fixed range
+// overlaps the constraint interval [lower, upper), by testing interval
+// intersection directly rather than probing individual versions — so it
+// doesn't miss affected ranges whose boundaries happen to be the ones a
+// point probe can't represent (e.g. "introduced":"0", or a ".0" fixed
+// version). A range can carry multiple introduced/fixed segments; each is
+// checked independently.
+func rangeIntersectsConstraint(r osvRange, lower, upper *bound) bool {
+	var introduced *bound
+	for _, event := range r.Events {
+		if event.Introduced != "" {
+			if v, err := versionOrZero(event.Introduced); err == nil {
+				introduced = &bound{version: v, inclusive: true}
+			}
+		}
+		if event.Fixed == "" {
+			continue
+		}
+
+		fixedVersion, err := version.NewVersion(event.Fixed)
+		if err == nil {
+			rangeLower := introduced
+			if rangeLower == nil {
+				rangeLower, _ = zeroBound()
+			}
+			if boundsOverlap(lower, upper, rangeLower, &bound{version: fixedVersion, inclusive: false}) {
+				return true
+			}
+		}
+		introduced = nil
+	}
+
+	// The last segment had no Fixed event: the range is still open, so it
+	// overlaps the constraint if the constraint admits anything >= introduced.
+	if introduced != nil {
+		return boundsOverlap(lower, upper, introduced, nil)
+	}
+	return false
+}
+
+// bound is one endpoint (lower or upper) of a half-open version interval.
+// A nil *bound means unbounded in that direction.
+type bound struct {
+	version   *version.Version
+	inclusive bool
+}
+
+func versionOrZero(raw string) (*version.Version, error) {
+	if raw == "" || raw == "0" {
+		return version.NewVersion("0.0.0")
+	}
+	return version.NewVersion(raw)
+}
+
+func zeroBound() (*bound, error) {
+	v, err := version.NewVersion("0.0.0")
+	if err != nil {
+		return nil, err
+	}
+	return &bound{version: v, inclusive: true}, nil
+}
+
+// tighterLower returns whichever of a, b admits fewer versions from below
+// (i.e. the greater lower bound); nil (unbounded) always loses.
+func tighterLower(a, b *bound) *bound {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	switch a.version.Compare(b.version) {
+	case 1:
+		return a
+	case -1:
+		return b
+	default:
+		return &bound{version: a.version, inclusive: a.inclusive && b.inclusive}
+	}
+}
+
+// tighterUpper returns whichever of a, b admits fewer versions from above
+// (i.e. the lesser upper bound); nil (unbounded) always loses.
+func tighterUpper(a, b *bound) *bound {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	switch a.version.Compare(b.version) {
+	case -1:
+		return a
+	case 1:
+		return b
+	default:
+		return &bound{version: a.version, inclusive: a.inclusive && b.inclusive}
+	}
+}
+
+// boundsOverlap reports whether the interval [lowA, upA) and [lowB, upB)
+// share at least one admissible version.
+func boundsOverlap(lowA, upA, lowB, upB *bound) bool {
+	lo := tighterLower(lowA, lowB)
+	up := tighterUpper(upA, upB)
+	if lo == nil || up == nil {
+		return true
+	}
+	switch lo.version.Compare(up.version) {
+	case -1:
+		return true
+	case 1:
+		return false
+	default:
+		return lo.inclusive && up.inclusive
+	}
+}
+
+// constraintTermRegexp matches a single comma-separated constraint term,
+// e.g. "~> 3.0" or ">=1.2.3", splitting it into its operator and version.
+var constraintTermRegexp = regexp.MustCompile(`^\s*(~>|>=|<=|!=|>|<|=)?\s*(.+?)\s*$`)
+
+// parseConstraintBounds derives the half-open interval [lower, upper)
+// admitted by a (possibly multi-term, comma-separated) version constraint,
+// so it can be intersected with an OSV affected range without enumerating
+// every published version. "!=" terms can't be expressed as a bound and are
+// ignored, which only widens the interval — safe here, since a false
+// positive (checking a version that's actually excluded) is preferable to
+// silently missing an applicable advisory.
+func parseConstraintBounds(constraintStr string) (lower, upper *bound, err error) {
+	for _, term := range strings.Split(constraintStr, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		matches := constraintTermRegexp.FindStringSubmatch(term)
+		if matches == nil {
+			return nil, nil, fmt.Errorf("malformed constraint term %q", term)
+		}
+		op, raw := matches[1], matches[2]
+
+		v, vErr := version.NewVersion(raw)
+		if vErr != nil {
+			return nil, nil, vErr
+		}
+
+		switch op {
+		case "", "=":
+			lower = tighterLower(lower, &bound{version: v, inclusive: true})
+			upper = tighterUpper(upper, &bound{version: v, inclusive: true})
+		case ">":
+			lower = tighterLower(lower, &bound{version: v, inclusive: false})
+		case ">=":
+			lower = tighterLower(lower, &bound{version: v, inclusive: true})
+		case "<":
+			upper = tighterUpper(upper, &bound{version: v, inclusive: false})
+		case "<=":
+			upper = tighterUpper(upper, &bound{version: v, inclusive: true})
+		case "~>":
+			ceiling, cErr := pessimisticCeiling(v)
+			if cErr != nil {
+				return nil, nil, cErr
+			}
+			lower = tighterLower(lower, &bound{version: v, inclusive: true})
+			upper = tighterUpper(upper, &bound{version: ceiling, inclusive: false})
+		case "!=":
+			// Excluding a single point doesn't narrow a continuous interval.
+		}
+	}
+	return lower, upper, nil
+}
+
+// pessimisticCeiling returns the exclusive upper bound for a "~>" pessimistic
+// constraint: one minor release above v if v pins major.minor (or just
+// major), or one patch release above v if v pins major.minor.patch.
+func pessimisticCeiling(v *version.Version) (*version.Version, error) {
+	segments := v.Segments64()
+	if segmentCount(v) <= 2 {
+		return version.NewVersion(fmt.Sprintf("%d.0.0", segments[0]+1))
+	}
+	return version.NewVersion(fmt.Sprintf("%d.%d.0", segments[0], segments[1]+1))
+}
+
+// segmentCount returns how many dot-separated segments the user actually
+// wrote (ignoring any prerelease/metadata suffix), since go-version always
+// reports 3 zero-padded segments regardless of what was written.
+func segmentCount(v *version.Version) int {
+	base := v.Original()
+	if i := strings.IndexAny(base, "-+"); i >= 0 {
+		base = base[:i]
+	}
+	return strings.Count(base, ".") + 1
+}
+
+type cacheEntry struct {
+	FetchedAt  time.Time  `json:"fetched_at"`
+	Advisories []Advisory `json:"advisories"`
+}
+
+func (c *Checker) cachePath(providerName, constraint string) string {
+	sum := sha256.Sum256([]byte(providerName + "@" + constraint))
+	return filepath.Join(c.cacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *Checker) readCache(providerName, constraint string) ([]Advisory, bool) {
+	if c.cacheDir == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(c.cachePath(providerName, constraint))
+	if err != nil {
+		return nil, false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	if time.Since(entry.FetchedAt) > cacheTTL {
+		return nil, false
+	}
+	return entry.Advisories, true
+}
+
+func (c *Checker) writeCache(providerName, constraint string, advisories []Advisory) {
+	if c.cacheDir == "" {
+		return
+	}
+	if err := os.MkdirAll(c.cacheDir, 0o755); err != nil {
+		return
+	}
+	data, err := json.Marshal(cacheEntry{FetchedAt: time.Now(), Advisories: advisories})
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(c.cachePath(providerName, constraint), data, 0o644)
+}
@@ -0,0 +1,189 @@
+package vulncheck
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeOfflineMirror(t *testing.T, dir, providerName string, resp osvQueryResponse) {
+	t.Helper()
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("failed to marshal fixture: %v", err)
+	}
+	path := filepath.Join(dir, sanitizeFilename(candidatePackages(providerName)[0].Name)+".json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("failed to write offline mirror fixture: %v", err)
+	}
+}
+
+func TestQueryOfflineFlagsApplicableAdvisory(t *testing.T) {
+	dir := t.TempDir()
+	writeOfflineMirror(t, dir, "azurerm", osvQueryResponse{
+		Vulns: []osvVuln{{
+			ID:      "GHSA-test-1234",
+			Summary: "example advisory",
+			Affected: []osvAffected{{
+				Ranges: []osvRange{{
+					Events: []osvEvent{
+						{Introduced: "3.0.0"},
+						{Fixed: "3.5.1"},
+					},
+				}},
+			}},
+		}},
+	})
+
+	checker := NewChecker(WithOfflineDir(dir))
+	advisories, err := checker.Query(context.Background(), "azurerm", "~> 3.1")
+	if err != nil {
+		t.Fatalf("Query returned an error: %v", err)
+	}
+	if len(advisories) != 1 || advisories[0].ID != "GHSA-test-1234" {
+		t.Fatalf("expected the advisory to apply, got %+v", advisories)
+	}
+}
+
+func TestQueryOfflineSkipsAdvisoryOutsideConstraint(t *testing.T) {
+	dir := t.TempDir()
+	writeOfflineMirror(t, dir, "azurerm", osvQueryResponse{
+		Vulns: []osvVuln{{
+			ID: "GHSA-test-5678",
+			Affected: []osvAffected{{
+				Ranges: []osvRange{{
+					Events: []osvEvent{
+						{Introduced: "2.0.0"},
+						{Fixed: "2.5.0"},
+					},
+				}},
+			}},
+		}},
+	})
+
+	checker := NewChecker(WithOfflineDir(dir))
+	advisories, err := checker.Query(context.Background(), "azurerm", "~> 4.0")
+	if err != nil {
+		t.Fatalf("Query returned an error: %v", err)
+	}
+	if len(advisories) != 0 {
+		t.Fatalf("expected no advisories to apply, got %+v", advisories)
+	}
+}
+
+func TestQueryOfflineMissingMirrorFileIsNotAnError(t *testing.T) {
+	checker := NewChecker(WithOfflineDir(t.TempDir()))
+	advisories, err := checker.Query(context.Background(), "azapi", ">= 2.0.0")
+	if err != nil {
+		t.Fatalf("Query returned an error: %v", err)
+	}
+	if len(advisories) != 0 {
+		t.Fatalf("expected no advisories for a provider with no mirror fixture, got %+v", advisories)
+	}
+}
+
+func TestQueryCachesResultOnDisk(t *testing.T) {
+	cacheDir := t.TempDir()
+	offlineDir := t.TempDir()
+	writeOfflineMirror(t, offlineDir, "azurerm", osvQueryResponse{
+		Vulns: []osvVuln{{
+			ID: "GHSA-cache-0001",
+			Affected: []osvAffected{{
+				Ranges: []osvRange{{Events: []osvEvent{{Introduced: "1.0.0"}}}},
+			}},
+		}},
+	})
+
+	checker := NewChecker(WithOfflineDir(offlineDir), WithCacheDir(cacheDir))
+	if _, err := checker.Query(context.Background(), "azurerm", ">= 1.0.0"); err != nil {
+		t.Fatalf("Query returned an error: %v", err)
+	}
+
+	cached, ok := checker.readCache("azurerm", ">= 1.0.0")
+	if !ok {
+		t.Fatal("expected the result to be cached on disk")
+	}
+	if len(cached) != 1 || cached[0].ID != "GHSA-cache-0001" {
+		t.Fatalf("expected the cached advisory to be recorded, got %+v", cached)
+	}
+}
+
+func TestQueryFlagsConstraintWithinZeroIntroducedRange(t *testing.T) {
+	dir := t.TempDir()
+	writeOfflineMirror(t, dir, "azurerm", osvQueryResponse{
+		Vulns: []osvVuln{{
+			ID: "GHSA-zero-introduced",
+			Affected: []osvAffected{{
+				Ranges: []osvRange{{
+					Events: []osvEvent{
+						{Introduced: "0"},
+						{Fixed: "4.0.0"},
+					},
+				}},
+			}},
+		}},
+	})
+
+	checker := NewChecker(WithOfflineDir(dir))
+	advisories, err := checker.Query(context.Background(), "azurerm", "~> 3.0")
+	if err != nil {
+		t.Fatalf("Query returned an error: %v", err)
+	}
+	if len(advisories) != 1 || advisories[0].ID != "GHSA-zero-introduced" {
+		t.Fatalf("expected the advisory covering [0, 4.0.0) to apply to ~> 3.0, got %+v", advisories)
+	}
+}
+
+func TestQueryFlagsConstraintAgainstDotZeroFixedVersion(t *testing.T) {
+	dir := t.TempDir()
+	writeOfflineMirror(t, dir, "azurerm", osvQueryResponse{
+		Vulns: []osvVuln{{
+			ID: "GHSA-dot-zero-fixed",
+			Affected: []osvAffected{{
+				Ranges: []osvRange{{
+					Events: []osvEvent{
+						{Introduced: "3.0.0"},
+						{Fixed: "3.80.0"},
+					},
+				}},
+			}},
+		}},
+	})
+
+	checker := NewChecker(WithOfflineDir(dir))
+	advisories, err := checker.Query(context.Background(), "azurerm", "~> 3.50")
+	if err != nil {
+		t.Fatalf("Query returned an error: %v", err)
+	}
+	if len(advisories) != 1 || advisories[0].ID != "GHSA-dot-zero-fixed" {
+		t.Fatalf("expected the advisory covering [3.0.0, 3.80.0) to apply to ~> 3.50, got %+v", advisories)
+	}
+}
+
+func TestQuerySkipsAdvisoryFixedBeforeConstraintFloor(t *testing.T) {
+	dir := t.TempDir()
+	writeOfflineMirror(t, dir, "azurerm", osvQueryResponse{
+		Vulns: []osvVuln{{
+			ID: "GHSA-already-fixed",
+			Affected: []osvAffected{{
+				Ranges: []osvRange{{
+					Events: []osvEvent{
+						{Introduced: "1.0.0"},
+						{Fixed: "2.0.0"},
+					},
+				}},
+			}},
+		}},
+	})
+
+	checker := NewChecker(WithOfflineDir(dir))
+	advisories, err := checker.Query(context.Background(), "azurerm", ">= 3.0.0")
+	if err != nil {
+		t.Fatalf("Query returned an error: %v", err)
+	}
+	if len(advisories) != 0 {
+		t.Fatalf("expected no advisories for a range entirely below the constraint floor, got %+v", advisories)
+	}
+}